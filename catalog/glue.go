@@ -2,9 +2,15 @@ package catalog
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
+	"strconv"
+	"strings"
 
+	"github.com/apache/iceberg-go"
+	"github.com/apache/iceberg-go/io"
 	"github.com/apache/iceberg-go/table"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
@@ -15,21 +21,92 @@ var (
 	_ Catalog = (*GlueCatalog)(nil)
 )
 
+// ErrCommitConflict is returned by CommitTable when the table's metadata_location in
+// Glue no longer matches the location the caller loaded the table from, meaning a
+// concurrent writer already committed a newer version.
+var ErrCommitConflict = errors.New("glue: table was concurrently modified, reload and retry the commit")
+
 type GlueAPI interface {
 	GetTable(ctx context.Context, params *glue.GetTableInput, optFns ...func(*glue.Options)) (*glue.GetTableOutput, error)
 	GetTables(ctx context.Context, params *glue.GetTablesInput, optFns ...func(*glue.Options)) (*glue.GetTablesOutput, error)
+	CreateTable(ctx context.Context, params *glue.CreateTableInput, optFns ...func(*glue.Options)) (*glue.CreateTableOutput, error)
+	UpdateTable(ctx context.Context, params *glue.UpdateTableInput, optFns ...func(*glue.Options)) (*glue.UpdateTableOutput, error)
+	DeleteTable(ctx context.Context, params *glue.DeleteTableInput, optFns ...func(*glue.Options)) (*glue.DeleteTableOutput, error)
+	CreateDatabase(ctx context.Context, params *glue.CreateDatabaseInput, optFns ...func(*glue.Options)) (*glue.CreateDatabaseOutput, error)
+	DeleteDatabase(ctx context.Context, params *glue.DeleteDatabaseInput, optFns ...func(*glue.Options)) (*glue.DeleteDatabaseOutput, error)
+	GetDatabase(ctx context.Context, params *glue.GetDatabaseInput, optFns ...func(*glue.Options)) (*glue.GetDatabaseOutput, error)
+	GetDatabases(ctx context.Context, params *glue.GetDatabasesInput, optFns ...func(*glue.Options)) (*glue.GetDatabasesOutput, error)
+	UpdateDatabase(ctx context.Context, params *glue.UpdateDatabaseInput, optFns ...func(*glue.Options)) (*glue.UpdateDatabaseOutput, error)
 }
 
 type GlueCatalog struct {
-	glueSvc GlueAPI
+	glueSvc   GlueAPI
+	catalogID string
+
+	// serverSideIcebergCreate makes CreateTable use Glue's native Iceberg support
+	// (OpenTableFormatInput) instead of writing the initial metadata file itself.
+	serverSideIcebergCreate bool
+}
+
+// GlueOption configures optional parameters for NewGlueCatalog.
+type GlueOption func(*glueOptions)
+
+type glueOptions struct {
+	catalogID               string
+	endpoint                string
+	serverSideIcebergCreate bool
+}
+
+// WithCatalogID sets the Glue Data Catalog account ID to target, for cross-account
+// access. It is sent as CatalogId on every Glue request. When unset, Glue defaults
+// to the caller's own account's catalog.
+func WithCatalogID(catalogID string) GlueOption {
+	return func(o *glueOptions) { o.catalogID = catalogID }
+}
+
+// WithGlueEndpoint overrides the Glue service endpoint, e.g. to target a VPC
+// endpoint or a local test double.
+func WithGlueEndpoint(endpoint string) GlueOption {
+	return func(o *glueOptions) { o.endpoint = endpoint }
 }
 
-func NewGlueCatalog(awscfg aws.Config) *GlueCatalog {
+// WithServerSideIcebergCreate makes CreateTable use Glue's native Iceberg support
+// (OpenTableFormatInput) instead of writing the initial metadata file itself: Glue
+// materializes the initial metadata, and GlueCatalog reads it back afterward. This
+// is useful in Lake Formation / IAM-restricted environments where the caller can
+// call Glue but cannot write to the warehouse bucket directly.
+func WithServerSideIcebergCreate() GlueOption {
+	return func(o *glueOptions) { o.serverSideIcebergCreate = true }
+}
+
+func NewGlueCatalog(awscfg aws.Config, opts ...GlueOption) *GlueCatalog {
+	var o glueOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	glueSvc := glue.NewFromConfig(awscfg, func(glueOpts *glue.Options) {
+		if o.endpoint != "" {
+			glueOpts.BaseEndpoint = aws.String(o.endpoint)
+		}
+	})
+
 	return &GlueCatalog{
-		glueSvc: glue.NewFromConfig(awscfg),
+		glueSvc:                 glueSvc,
+		catalogID:               o.catalogID,
+		serverSideIcebergCreate: o.serverSideIcebergCreate,
 	}
 }
 
+// catalogIDPtr returns the CatalogId to send on a Glue request, or nil to let Glue
+// default to the caller's own account.
+func (c *GlueCatalog) catalogIDPtr() *string {
+	if c.catalogID == "" {
+		return nil
+	}
+	return aws.String(c.catalogID)
+}
+
 // GetTable loads a table from the Glue Catalog using the given database and table name.
 func (c *GlueCatalog) GetTable(ctx context.Context, identifier table.Identifier) (CatalogTable, error) {
 	database, tableName, err := identifierToGlueTable(identifier)
@@ -39,12 +116,14 @@ func (c *GlueCatalog) GetTable(ctx context.Context, identifier table.Identifier)
 
 	tblRes, err := c.glueSvc.GetTable(ctx,
 		&glue.GetTableInput{
+			CatalogId:    c.catalogIDPtr(),
 			DatabaseName: aws.String(database),
 			Name:         aws.String(tableName),
 		},
 	)
 	if err != nil {
-		if errors.Is(err, &types.EntityNotFoundException{}) {
+		var nfErr *types.EntityNotFoundException
+		if errors.As(err, &nfErr) {
 			return CatalogTable{}, ErrNoSuchTable
 		}
 		return CatalogTable{}, fmt.Errorf("failed to get table %s.%s: %w", database, tableName, err)
@@ -61,39 +140,683 @@ func (c *GlueCatalog) GetTable(ctx context.Context, identifier table.Identifier)
 	}, nil
 }
 
-// ListTables returns a list of iceberg tables in the given Glue database.
+// ListTables returns a list of iceberg tables in the given Glue database. It pages
+// through the full database via NextToken, so databases with more tables than fit
+// in a single GetTables response are still returned in full.
 func (c *GlueCatalog) ListTables(ctx context.Context, identifier table.Identifier) ([]CatalogTable, error) {
 	database, err := identifierToGlueDatabase(identifier)
 	if err != nil {
 		return nil, err
 	}
 
-	params := &glue.GetTablesInput{DatabaseName: aws.String(database)}
+	params := &glue.GetTablesInput{
+		CatalogId:    c.catalogIDPtr(),
+		DatabaseName: aws.String(database),
+	}
+
+	var icebergTables []CatalogTable
+
+	for {
+		tblsRes, err := c.glueSvc.GetTables(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tables in namespace %s: %w", database, err)
+		}
+
+		for _, tbl := range tblsRes.TableList {
+			// skip non iceberg tables
+			// TODO: consider what this would look like for non ICEBERG tables as you can convert them to ICEBERG tables via the Glue catalog API.
+			if tbl.Parameters["table_type"] != "ICEBERG" {
+				continue
+			}
+
+			icebergTables = append(icebergTables,
+				CatalogTable{
+					Identifier:  GlueTableIdentifier(database, aws.ToString(tbl.Name)),
+					Location:    tbl.Parameters["metadata_location"],
+					CatalogType: Glue,
+				},
+			)
+		}
+
+		if tblsRes.NextToken == nil {
+			break
+		}
+		params.NextToken = tblsRes.NextToken
+	}
+
+	return icebergTables, nil
+}
+
+// CreateTableOption configures optional parameters for GlueCatalog.CreateTable and
+// RESTCatalog.CreateTable.
+type CreateTableOption func(*createTableOpts)
+
+type createTableOpts struct {
+	location      string
+	partitionSpec iceberg.PartitionSpec
+	sortOrder     table.SortOrder
+	properties    iceberg.Properties
+}
+
+// WithLocation overrides the warehouse location that the table's data and metadata
+// are written under. GlueCatalog.CreateTable requires it, since it derives no
+// default location from the namespace; RESTCatalog.CreateTable sends it only when
+// set.
+func WithLocation(location string) CreateTableOption {
+	return func(o *createTableOpts) { o.location = location }
+}
+
+// WithPartitionSpec sets the partition spec for a table created via CreateTable.
+func WithPartitionSpec(spec iceberg.PartitionSpec) CreateTableOption {
+	return func(o *createTableOpts) { o.partitionSpec = spec }
+}
+
+// WithSortOrder sets the sort order for a table created via CreateTable.
+func WithSortOrder(sortOrder table.SortOrder) CreateTableOption {
+	return func(o *createTableOpts) { o.sortOrder = sortOrder }
+}
+
+// WithProperties sets the table properties for a table created via CreateTable.
+func WithProperties(props iceberg.Properties) CreateTableOption {
+	return func(o *createTableOpts) { o.properties = props }
+}
+
+// CreateTable creates a new Iceberg table in the given Glue database. It builds the
+// initial table metadata, writes the versioned metadata file to the table's
+// warehouse location via the io.IO layer, and only then registers the table in Glue
+// with table_type=ICEBERG and metadata_location pointing at the file just written.
+// With WithServerSideIcebergCreate, no metadata file is written client-side; see
+// createTableServerSide.
+func (c *GlueCatalog) CreateTable(ctx context.Context, identifier table.Identifier, schema *iceberg.Schema, opts ...CreateTableOption) (*table.Table, error) {
+	database, tableName, err := identifierToGlueTable(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := createTableOpts{properties: make(iceberg.Properties)}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
 
-	tblsRes, err := c.glueSvc.GetTables(ctx, params)
+	if cfg.location == "" {
+		return nil, fmt.Errorf("create table %s.%s: location is required", database, tableName)
+	}
+
+	if c.serverSideIcebergCreate {
+		return c.createTableServerSide(ctx, identifier, database, tableName, schema, cfg)
+	}
+
+	metadataLocation, err := newMetadataLocation(cfg.location, 0)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list tables in namespace %s: %w", database, err)
+		return nil, err
 	}
 
-	var icebergTables []CatalogTable
+	tbl, err := table.NewTableBuilder(identifier, schema, cfg.location, metadataLocation).
+		WithPartitionSpec(cfg.partitionSpec).
+		WithSortOrder(cfg.sortOrder).
+		WithProperties(cfg.properties).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build table %s.%s: %w", database, tableName, err)
+	}
+
+	if err := writeTableMetadata(tbl.FS(), metadataLocation, tbl.Metadata()); err != nil {
+		return nil, fmt.Errorf("failed to write metadata for table %s.%s: %w", database, tableName, err)
+	}
+
+	_, err = c.glueSvc.CreateTable(ctx, &glue.CreateTableInput{
+		CatalogId:    c.catalogIDPtr(),
+		DatabaseName: aws.String(database),
+		TableInput: &types.TableInput{
+			Name: aws.String(tableName),
+			Parameters: map[string]string{
+				"table_type":        "ICEBERG",
+				"metadata_location": metadataLocation,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create table %s.%s in glue: %w", database, tableName, err)
+	}
+
+	return tbl, nil
+}
+
+// createTableServerSide creates a table by asking Glue to materialize the initial
+// Iceberg metadata itself, via OpenTableFormatInput, instead of GlueCatalog writing
+// the metadata.json file client-side. The caller's schema is passed as the
+// StorageDescriptor's columns, since that is what Glue uses to materialize the
+// Iceberg schema for MetadataOperationCreate; the partition spec has no equivalent
+// here, since Iceberg's hidden partitioning lives entirely in the metadata file and
+// Glue's PartitionKeys models Hive-style partition columns, a different concept.
+// It then reads the table back to learn the metadata_location Glue chose.
+func (c *GlueCatalog) createTableServerSide(ctx context.Context, identifier table.Identifier, database, tableName string, schema *iceberg.Schema, cfg createTableOpts) (*table.Table, error) {
+	_, err := c.glueSvc.CreateTable(ctx, &glue.CreateTableInput{
+		CatalogId:    c.catalogIDPtr(),
+		DatabaseName: aws.String(database),
+		TableInput: &types.TableInput{
+			Name: aws.String(tableName),
+			StorageDescriptor: &types.StorageDescriptor{
+				Location: aws.String(cfg.location),
+				Columns:  glueColumnsFromSchema(schema),
+			},
+			Parameters: cfg.properties,
+		},
+		OpenTableFormatInput: &types.OpenTableFormatInput{
+			IcebergInput: &types.IcebergInput{
+				MetadataOperation: types.MetadataOperationCreate,
+				Version:           aws.String("2"),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create table %s.%s in glue: %w", database, tableName, err)
+	}
+
+	res, err := c.glueSvc.GetTable(ctx, &glue.GetTableInput{
+		CatalogId:    c.catalogIDPtr(),
+		DatabaseName: aws.String(database),
+		Name:         aws.String(tableName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read back table %s.%s after server-side create: %w", database, tableName, err)
+	}
+
+	metadataLocation := res.Table.Parameters["metadata_location"]
+	if metadataLocation == "" {
+		return nil, fmt.Errorf("glue did not return a metadata_location for %s.%s after server-side create", database, tableName)
+	}
+
+	fs, err := io.LoadFS(map[string]string{}, metadataLocation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load fs: %w", err)
+	}
+
+	return table.NewFromLocation(identifier, metadataLocation, fs)
+}
+
+// glueColumnsFromSchema converts an Iceberg schema's fields into Glue
+// StorageDescriptor columns, so server-side Iceberg table creation
+// (WithServerSideIcebergCreate) has enough information to materialize the caller's
+// requested schema instead of creating an empty table.
+func glueColumnsFromSchema(schema *iceberg.Schema) []types.Column {
+	fields := schema.Fields()
+	columns := make([]types.Column, len(fields))
+	for i, f := range fields {
+		columns[i] = types.Column{
+			Name: aws.String(f.Name),
+			Type: aws.String(glueTypeString(f.Type)),
+		}
+	}
+	return columns
+}
+
+// glueTypeString maps an Iceberg primitive type to the Hive-style type string Glue
+// expects in a Column. Nested types (struct/list/map) are passed through via their
+// Iceberg string form, which Glue accepts but does not validate structurally.
+func glueTypeString(t iceberg.Type) string {
+	switch s := t.String(); s {
+	case "long":
+		return "bigint"
+	case "timestamptz":
+		return "timestamp"
+	default:
+		return s
+	}
+}
+
+// DropTable removes a table's entry from the Glue Data Catalog. It does not delete
+// the underlying data or metadata files.
+func (c *GlueCatalog) DropTable(ctx context.Context, identifier table.Identifier) error {
+	database, tableName, err := identifierToGlueTable(identifier)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.glueSvc.DeleteTable(ctx, &glue.DeleteTableInput{
+		CatalogId:    c.catalogIDPtr(),
+		DatabaseName: aws.String(database),
+		Name:         aws.String(tableName),
+	})
+	if err != nil {
+		var nfErr *types.EntityNotFoundException
+		if errors.As(err, &nfErr) {
+			return ErrNoSuchTable
+		}
+		return fmt.Errorf("failed to drop table %s.%s: %w", database, tableName, err)
+	}
 
-	for _, tbl := range tblsRes.TableList {
-		// skip non iceberg tables
-		// TODO: consider what this would look like for non ICEBERG tables as you can convert them to ICEBERG tables via the Glue catalog API.
-		if tbl.Parameters["table_type"] != "ICEBERG" {
-			continue
+	return nil
+}
+
+// RenameTable renames an Iceberg table by registering its current metadata_location
+// under the new identifier and dropping the old Glue entry.
+func (c *GlueCatalog) RenameTable(ctx context.Context, identifier, newIdentifier table.Identifier) (*table.Table, error) {
+	srcDatabase, srcTableName, err := identifierToGlueTable(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	dstDatabase, dstTableName, err := identifierToGlueTable(newIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := c.glueSvc.GetTable(ctx, &glue.GetTableInput{
+		CatalogId:    c.catalogIDPtr(),
+		DatabaseName: aws.String(srcDatabase),
+		Name:         aws.String(srcTableName),
+	})
+	if err != nil {
+		var nfErr *types.EntityNotFoundException
+		if errors.As(err, &nfErr) {
+			return nil, ErrNoSuchTable
 		}
+		return nil, fmt.Errorf("failed to load table %s.%s: %w", srcDatabase, srcTableName, err)
+	}
+
+	if src.Table.Parameters["table_type"] != "ICEBERG" {
+		return nil, fmt.Errorf("table %s.%s is not an iceberg table", srcDatabase, srcTableName)
+	}
+
+	metadataLocation := src.Table.Parameters["metadata_location"]
+	if metadataLocation == "" {
+		return nil, fmt.Errorf("table %s.%s has no metadata_location", srcDatabase, srcTableName)
+	}
 
-		icebergTables = append(icebergTables,
-			CatalogTable{
-				Identifier:  GlueTableIdentifier(database, aws.ToString(tbl.Name)),
-				Location:    tbl.Parameters["metadata_location"],
-				CatalogType: Glue,
+	_, err = c.glueSvc.CreateTable(ctx, &glue.CreateTableInput{
+		CatalogId:    c.catalogIDPtr(),
+		DatabaseName: aws.String(dstDatabase),
+		TableInput: &types.TableInput{
+			Name: aws.String(dstTableName),
+			Parameters: map[string]string{
+				"table_type":        "ICEBERG",
+				"metadata_location": metadataLocation,
 			},
-		)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register table %s.%s in glue: %w", dstDatabase, dstTableName, err)
 	}
 
-	return icebergTables, nil
+	if err := c.DropTable(ctx, identifier); err != nil {
+		return nil, fmt.Errorf("failed to drop source table %s.%s after rename: %w", srcDatabase, srcTableName, err)
+	}
+
+	fs, err := io.LoadFS(map[string]string{}, metadataLocation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load fs: %w", err)
+	}
+
+	return table.NewFromLocation(newIdentifier, metadataLocation, fs)
+}
+
+// metadataUpdateAction is the TableUpdate action GlueCatalog.CommitTable looks for
+// in updates: it carries the caller's already-built replacement table.Metadata
+// wholesale, via NewMetadataUpdate, since GlueCatalog has no engine of its own for
+// applying incremental update actions the way a REST catalog server does. This is a
+// GlueCatalog-specific convention, not a REST Catalog spec action: RESTCatalog's
+// server expects standard actions (add-schema, add-snapshot, ...) and will reject
+// a "set-metadata" update, same as GlueCatalog will reject standard actions it
+// doesn't understand. See the CommitTable doc comment below.
+const metadataUpdateAction = "set-metadata"
+
+// NewMetadataUpdate wraps newMetadata as a TableUpdate carrying a fully built
+// replacement metadata object, for use with GlueCatalog.CommitTable specifically;
+// it is not a standard REST Catalog spec update and RESTCatalog does not accept it.
+func NewMetadataUpdate(newMetadata table.Metadata) TableUpdate {
+	return TableUpdate{"action": metadataUpdateAction, "metadata": newMetadata}
+}
+
+// metadataFromUpdates extracts the replacement table.Metadata carried by a
+// NewMetadataUpdate entry in updates, and rejects any other update action.
+func metadataFromUpdates(updates []TableUpdate) (table.Metadata, error) {
+	for _, u := range updates {
+		if u["action"] != metadataUpdateAction {
+			return nil, fmt.Errorf("commit table: unsupported update action %v, only %q updates built via NewMetadataUpdate are accepted", u["action"], metadataUpdateAction)
+		}
+		if meta, ok := u["metadata"].(table.Metadata); ok {
+			return meta, nil
+		}
+	}
+	return nil, fmt.Errorf("commit table: updates must include a %q update built via NewMetadataUpdate", metadataUpdateAction)
+}
+
+// CommitTable atomically replaces tbl's metadata with the replacement metadata
+// carried by updates (see NewMetadataUpdate). It writes the new metadata to a new
+// versioned metadata file and then swaps Glue's metadata_location parameter to
+// point at it, guarding the swap by comparing against the metadata_location that
+// tbl was loaded from. This mirrors Iceberg's compare-and-swap commit protocol: if
+// another writer has already advanced the table, the swap is refused with
+// ErrCommitConflict instead of silently overwriting the newer commit. requirements
+// is accepted for signature parity with RESTCatalog.CommitTable but is not
+// evaluated: GlueCatalog enforces its own optimistic lock directly against
+// tbl.MetadataLocation().
+//
+// GlueCatalog and RESTCatalog deliberately share this Go method signature so both
+// satisfy the same Catalog interface method, but they do NOT share a wire
+// contract: GlueCatalog requires updates built via NewMetadataUpdate, while
+// RESTCatalog forwards updates verbatim as standard REST Catalog spec actions to
+// its server. A caller cannot build one set of updates and commit against either
+// catalog interchangeably; it must know which concrete catalog it holds and build
+// updates accordingly.
+func (c *GlueCatalog) CommitTable(ctx context.Context, tbl *table.Table, requirements []TableRequirement, updates []TableUpdate) (*table.Table, error) {
+	newMetadata, err := metadataFromUpdates(updates)
+	if err != nil {
+		return nil, err
+	}
+
+	database, tableName, err := identifierToGlueTable(tbl.Identifier())
+	if err != nil {
+		return nil, err
+	}
+
+	currentVersion, err := metadataVersion(tbl.MetadataLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	metadataLocation, err := newMetadataLocation(tbl.Location(), currentVersion+1)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeTableMetadata(tbl.FS(), metadataLocation, newMetadata); err != nil {
+		return nil, fmt.Errorf("failed to write metadata for table %s.%s: %w", database, tableName, err)
+	}
+
+	current, err := c.glueSvc.GetTable(ctx, &glue.GetTableInput{
+		CatalogId:    c.catalogIDPtr(),
+		DatabaseName: aws.String(database),
+		Name:         aws.String(tableName),
+	})
+	if err != nil {
+		var nfErr *types.EntityNotFoundException
+		if errors.As(err, &nfErr) {
+			return nil, ErrNoSuchTable
+		}
+		return nil, fmt.Errorf("failed to load table %s.%s: %w", database, tableName, err)
+	}
+
+	// Fail fast on the first observed mismatch rather than re-polling Glue for
+	// metadata_location to settle: nothing about re-issuing the identical GetTable
+	// call changes between attempts, so retrying here only adds latency and Glue API
+	// load without improving the odds of success. A caller that wants to retry a
+	// conflicting commit is expected to reload the table (picking up the concurrent
+	// writer's metadata_location) and resubmit, the same contract RESTCatalog's
+	// callers follow on a 409.
+	if current.Table.Parameters["metadata_location"] != tbl.MetadataLocation() {
+		return nil, fmt.Errorf("commit table %s.%s: %w", database, tableName, ErrCommitConflict)
+	}
+
+	_, err = c.glueSvc.UpdateTable(ctx, &glue.UpdateTableInput{
+		CatalogId:    c.catalogIDPtr(),
+		DatabaseName: aws.String(database),
+		// VersionId makes Glue refuse the update if the table changed after the
+		// GetTable above, closing the window between the check and the swap.
+		VersionId: current.Table.VersionId,
+		TableInput: &types.TableInput{
+			Name: aws.String(tableName),
+			Parameters: map[string]string{
+				"table_type":                 "ICEBERG",
+				"metadata_location":          metadataLocation,
+				"previous_metadata_location": tbl.MetadataLocation(),
+			},
+		},
+	})
+	if err != nil {
+		var cmErr *types.ConcurrentModificationException
+		if errors.As(err, &cmErr) {
+			return nil, fmt.Errorf("commit table %s.%s: %w", database, tableName, ErrCommitConflict)
+		}
+		return nil, fmt.Errorf("failed to update table %s.%s in glue: %w", database, tableName, err)
+	}
+
+	return table.NewFromLocation(tbl.Identifier(), metadataLocation, tbl.FS())
+}
+
+// newMetadataLocation builds the path of the versioned metadata file for the given
+// table location and version number.
+func newMetadataLocation(location string, version int) (string, error) {
+	fileName, err := table.GenerateMetadataFileName(version)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate metadata file name: %w", err)
+	}
+
+	return fmt.Sprintf("%s/metadata/%s", strings.TrimSuffix(location, "/"), fileName), nil
+}
+
+// metadataVersion extracts the zero-padded version number prefix from a metadata
+// file name generated by table.GenerateMetadataFileName.
+func metadataVersion(metadataLocation string) (int, error) {
+	base := path.Base(metadataLocation)
+
+	version, _, ok := strings.Cut(base, "-")
+	if !ok {
+		return 0, fmt.Errorf("malformed metadata location %q", metadataLocation)
+	}
+
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return 0, fmt.Errorf("malformed metadata location %q: %w", metadataLocation, err)
+	}
+
+	return v, nil
+}
+
+// writeTableMetadata marshals meta and writes it to location using fs's write
+// support.
+func writeTableMetadata(fs io.IO, location string, meta table.Metadata) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	wf, ok := fs.(io.WriteFileIO)
+	if !ok {
+		return fmt.Errorf("io implementation %T does not support writing files", fs)
+	}
+
+	return wf.WriteFile(location, data)
+}
+
+// Well-known Iceberg namespace properties that map onto dedicated Glue database
+// fields rather than free-form Parameters.
+const (
+	namespaceLocationProperty    = "location"
+	namespaceDescriptionProperty = "description"
+)
+
+// CreateNamespace creates a Glue database to back an Iceberg namespace. The
+// "location" and "description" properties are mapped onto the database's
+// LocationUri and Description; all other properties (including "owner") are stored
+// verbatim in DatabaseInput.Parameters.
+func (c *GlueCatalog) CreateNamespace(ctx context.Context, identifier table.Identifier, props iceberg.Properties) error {
+	database, err := identifierToGlueDatabase(identifier)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.glueSvc.CreateDatabase(ctx, &glue.CreateDatabaseInput{
+		CatalogId:     c.catalogIDPtr(),
+		DatabaseInput: databaseInputFromProperties(database, props),
+	})
+	if err != nil {
+		var aeErr *types.AlreadyExistsException
+		if errors.As(err, &aeErr) {
+			return ErrNamespaceAlreadyExists
+		}
+		return fmt.Errorf("failed to create namespace %s: %w", database, err)
+	}
+
+	return nil
+}
+
+// DropNamespace deletes the Glue database backing an Iceberg namespace. Glue
+// refuses to delete a database that still contains tables, so callers must drop
+// the namespace's tables first.
+func (c *GlueCatalog) DropNamespace(ctx context.Context, identifier table.Identifier) error {
+	database, err := identifierToGlueDatabase(identifier)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.glueSvc.DeleteDatabase(ctx, &glue.DeleteDatabaseInput{CatalogId: c.catalogIDPtr(), Name: aws.String(database)})
+	if err != nil {
+		var nfErr *types.EntityNotFoundException
+		if errors.As(err, &nfErr) {
+			return ErrNoSuchNamespace
+		}
+		return fmt.Errorf("failed to drop namespace %s: %w", database, err)
+	}
+
+	return nil
+}
+
+// ListNamespaces returns the Glue databases available as Iceberg namespaces. Glue
+// has no concept of nested databases, so a non-empty parent is rejected.
+func (c *GlueCatalog) ListNamespaces(ctx context.Context, parent table.Identifier) ([]table.Identifier, error) {
+	if len(parent) != 0 {
+		return nil, fmt.Errorf("glue catalog does not support nested namespaces, got parent %v", parent)
+	}
+
+	params := &glue.GetDatabasesInput{CatalogId: c.catalogIDPtr()}
+
+	var namespaces []table.Identifier
+
+	for {
+		res, err := c.glueSvc.GetDatabases(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+
+		for _, db := range res.DatabaseList {
+			namespaces = append(namespaces, GlueDatabaseIdentifier(aws.ToString(db.Name)))
+		}
+
+		if res.NextToken == nil {
+			break
+		}
+		params.NextToken = res.NextToken
+	}
+
+	return namespaces, nil
+}
+
+// LoadNamespaceProperties returns the Iceberg namespace properties backed by a
+// Glue database.
+func (c *GlueCatalog) LoadNamespaceProperties(ctx context.Context, identifier table.Identifier) (iceberg.Properties, error) {
+	database, err := identifierToGlueDatabase(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.glueSvc.GetDatabase(ctx, &glue.GetDatabaseInput{CatalogId: c.catalogIDPtr(), Name: aws.String(database)})
+	if err != nil {
+		var nfErr *types.EntityNotFoundException
+		if errors.As(err, &nfErr) {
+			return nil, ErrNoSuchNamespace
+		}
+		return nil, fmt.Errorf("failed to load namespace %s: %w", database, err)
+	}
+
+	return propertiesFromDatabase(res.Database), nil
+}
+
+// PropertiesUpdateSummary reports which namespace property keys were removed,
+// updated, or requested for removal but absent, from a call to
+// UpdateNamespaceProperties.
+type PropertiesUpdateSummary struct {
+	Removed []string
+	Updated []string
+	Missing []string
+}
+
+// UpdateNamespaceProperties applies updates and removes the given property keys
+// from a Glue database's namespace properties in a single read-modify-write
+// UpdateDatabase call.
+func (c *GlueCatalog) UpdateNamespaceProperties(ctx context.Context, identifier table.Identifier, removals []string, updates iceberg.Properties) (PropertiesUpdateSummary, error) {
+	database, err := identifierToGlueDatabase(identifier)
+	if err != nil {
+		return PropertiesUpdateSummary{}, err
+	}
+
+	current, err := c.glueSvc.GetDatabase(ctx, &glue.GetDatabaseInput{CatalogId: c.catalogIDPtr(), Name: aws.String(database)})
+	if err != nil {
+		var nfErr *types.EntityNotFoundException
+		if errors.As(err, &nfErr) {
+			return PropertiesUpdateSummary{}, ErrNoSuchNamespace
+		}
+		return PropertiesUpdateSummary{}, fmt.Errorf("failed to load namespace %s: %w", database, err)
+	}
+
+	props := propertiesFromDatabase(current.Database)
+
+	var summary PropertiesUpdateSummary
+	for _, key := range removals {
+		if _, ok := props[key]; ok {
+			delete(props, key)
+			summary.Removed = append(summary.Removed, key)
+		} else {
+			summary.Missing = append(summary.Missing, key)
+		}
+	}
+	for key, value := range updates {
+		props[key] = value
+		summary.Updated = append(summary.Updated, key)
+	}
+
+	_, err = c.glueSvc.UpdateDatabase(ctx, &glue.UpdateDatabaseInput{
+		CatalogId:     c.catalogIDPtr(),
+		Name:          aws.String(database),
+		DatabaseInput: databaseInputFromProperties(database, props),
+	})
+	if err != nil {
+		return PropertiesUpdateSummary{}, fmt.Errorf("failed to update namespace %s: %w", database, err)
+	}
+
+	return summary, nil
+}
+
+// databaseInputFromProperties maps Iceberg namespace properties onto a Glue
+// DatabaseInput, pulling the well-known location/description keys into their
+// dedicated fields and storing everything else (including "owner") in Parameters.
+func databaseInputFromProperties(name string, props iceberg.Properties) *types.DatabaseInput {
+	input := &types.DatabaseInput{
+		Name:       aws.String(name),
+		Parameters: map[string]string{},
+	}
+
+	for k, v := range props {
+		switch k {
+		case namespaceLocationProperty:
+			input.LocationUri = aws.String(v)
+		case namespaceDescriptionProperty:
+			input.Description = aws.String(v)
+		default:
+			input.Parameters[k] = v
+		}
+	}
+
+	return input
+}
+
+// propertiesFromDatabase is the inverse of databaseInputFromProperties: it
+// reconstructs Iceberg namespace properties from a Glue database.
+func propertiesFromDatabase(db *types.Database) iceberg.Properties {
+	props := make(iceberg.Properties, len(db.Parameters)+2)
+	for k, v := range db.Parameters {
+		props[k] = v
+	}
+
+	if loc := aws.ToString(db.LocationUri); loc != "" {
+		props[namespaceLocationProperty] = loc
+	}
+	if desc := aws.ToString(db.Description); desc != "" {
+		props[namespaceDescriptionProperty] = desc
+	}
+
+	return props
 }
 
 func (c *GlueCatalog) CatalogType() CatalogType {
@@ -0,0 +1,73 @@
+package catalog
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/apache/iceberg-go"
+	"github.com/apache/iceberg-go/table"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+// TestGlueCatalog_UpdateNamespaceProperties_MergesAndRemoves exercises the
+// read-modify-write semantics of UpdateNamespaceProperties: updates are merged
+// into the existing properties, requested removals are deleted, a removal for a
+// key that was never set is reported as missing rather than erroring, and
+// properties the call didn't touch (like the namespace location) pass through
+// unchanged.
+func TestGlueCatalog_UpdateNamespaceProperties_MergesAndRemoves(t *testing.T) {
+	ctx := context.Background()
+
+	current := &types.Database{
+		Name:        aws.String("db"),
+		LocationUri: aws.String("s3://bucket/db"),
+		Parameters: map[string]string{
+			"owner": "alice",
+			"stale": "old",
+		},
+	}
+
+	var updatedInput *types.DatabaseInput
+	fake := &fakeGlueAPI{
+		getDatabase: func(*glue.GetDatabaseInput) (*glue.GetDatabaseOutput, error) {
+			return &glue.GetDatabaseOutput{Database: current}, nil
+		},
+		updateDatabase: func(in *glue.UpdateDatabaseInput) (*glue.UpdateDatabaseOutput, error) {
+			updatedInput = in.DatabaseInput
+			return &glue.UpdateDatabaseOutput{}, nil
+		},
+	}
+
+	c := &GlueCatalog{glueSvc: fake}
+
+	summary, err := c.UpdateNamespaceProperties(ctx, table.Identifier{"db"},
+		[]string{"stale", "missing"},
+		iceberg.Properties{"owner": "bob"},
+	)
+	if err != nil {
+		t.Fatalf("UpdateNamespaceProperties: %v", err)
+	}
+
+	if want := []string{"stale"}; !reflect.DeepEqual(summary.Removed, want) {
+		t.Errorf("Removed = %v, want %v", summary.Removed, want)
+	}
+	if want := []string{"missing"}; !reflect.DeepEqual(summary.Missing, want) {
+		t.Errorf("Missing = %v, want %v", summary.Missing, want)
+	}
+	if want := []string{"owner"}; !reflect.DeepEqual(summary.Updated, want) {
+		t.Errorf("Updated = %v, want %v", summary.Updated, want)
+	}
+
+	if got, want := updatedInput.Parameters["owner"], "bob"; got != want {
+		t.Errorf("owner = %q, want %q", got, want)
+	}
+	if _, ok := updatedInput.Parameters["stale"]; ok {
+		t.Errorf("stale should have been removed, got %v", updatedInput.Parameters)
+	}
+	if got, want := aws.ToString(updatedInput.LocationUri), "s3://bucket/db"; got != want {
+		t.Errorf("LocationUri = %q, want %q (untouched)", got, want)
+	}
+}
@@ -0,0 +1,149 @@
+package catalog
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/apache/iceberg-go/table"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+// TestGlueCatalog_ListTables_Paginates checks that ListTables follows NextToken
+// across multiple GetTables responses instead of only returning the first page,
+// which would silently truncate databases with more tables than fit in one Glue
+// response.
+func TestGlueCatalog_ListTables_Paginates(t *testing.T) {
+	ctx := context.Background()
+
+	pages := [][]types.Table{
+		{{Name: aws.String("one"), Parameters: map[string]string{"table_type": "ICEBERG", "metadata_location": "s3://bucket/one"}}},
+		{{Name: aws.String("two"), Parameters: map[string]string{"table_type": "ICEBERG", "metadata_location": "s3://bucket/two"}}},
+	}
+
+	var calls int
+	fake := &fakeGlueAPI{
+		getTables: func(in *glue.GetTablesInput) (*glue.GetTablesOutput, error) {
+			page := pages[calls]
+			calls++
+			out := &glue.GetTablesOutput{TableList: page}
+			if calls < len(pages) {
+				out.NextToken = aws.String("next")
+			}
+			return out, nil
+		},
+	}
+
+	c := &GlueCatalog{glueSvc: fake}
+
+	tables, err := c.ListTables(ctx, table.Identifier{"db"})
+	if err != nil {
+		t.Fatalf("ListTables: %v", err)
+	}
+
+	if calls != len(pages) {
+		t.Fatalf("GetTables called %d times, want %d", calls, len(pages))
+	}
+	if len(tables) != 2 {
+		t.Fatalf("got %d tables, want 2", len(tables))
+	}
+	if got, want := tables[0].Identifier, GlueTableIdentifier("db", "one"); !reflect.DeepEqual(got, want) {
+		t.Errorf("tables[0].Identifier = %v, want %v", got, want)
+	}
+	if got, want := tables[1].Identifier, GlueTableIdentifier("db", "two"); !reflect.DeepEqual(got, want) {
+		t.Errorf("tables[1].Identifier = %v, want %v", got, want)
+	}
+}
+
+// TestGlueCatalog_ListNamespaces_Paginates is ListTables' pagination test, for
+// GetDatabases/ListNamespaces instead.
+func TestGlueCatalog_ListNamespaces_Paginates(t *testing.T) {
+	ctx := context.Background()
+
+	pages := [][]types.Database{
+		{{Name: aws.String("db1")}},
+		{{Name: aws.String("db2")}},
+	}
+
+	var calls int
+	fake := &fakeGlueAPI{
+		getDatabases: func(*glue.GetDatabasesInput) (*glue.GetDatabasesOutput, error) {
+			page := pages[calls]
+			calls++
+			out := &glue.GetDatabasesOutput{DatabaseList: page}
+			if calls < len(pages) {
+				out.NextToken = aws.String("next")
+			}
+			return out, nil
+		},
+	}
+
+	c := &GlueCatalog{glueSvc: fake}
+
+	namespaces, err := c.ListNamespaces(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListNamespaces: %v", err)
+	}
+
+	if calls != len(pages) {
+		t.Fatalf("GetDatabases called %d times, want %d", calls, len(pages))
+	}
+	want := []table.Identifier{GlueDatabaseIdentifier("db1"), GlueDatabaseIdentifier("db2")}
+	if !reflect.DeepEqual(namespaces, want) {
+		t.Errorf("namespaces = %v, want %v", namespaces, want)
+	}
+}
+
+// TestGlueCatalog_CatalogID_ThreadsThroughRequests checks that a CatalogID set via
+// WithCatalogID is sent as CatalogId on Glue requests, for cross-account access,
+// rather than being silently dropped.
+func TestGlueCatalog_CatalogID_ThreadsThroughRequests(t *testing.T) {
+	ctx := context.Background()
+
+	var gotTables, gotDatabases *string
+	fake := &fakeGlueAPI{
+		getTables: func(in *glue.GetTablesInput) (*glue.GetTablesOutput, error) {
+			gotTables = in.CatalogId
+			return &glue.GetTablesOutput{}, nil
+		},
+		getDatabases: func(in *glue.GetDatabasesInput) (*glue.GetDatabasesOutput, error) {
+			gotDatabases = in.CatalogId
+			return &glue.GetDatabasesOutput{}, nil
+		},
+	}
+
+	c := &GlueCatalog{glueSvc: fake, catalogID: "123456789012"}
+
+	if _, err := c.ListTables(ctx, table.Identifier{"db"}); err != nil {
+		t.Fatalf("ListTables: %v", err)
+	}
+	if _, err := c.ListNamespaces(ctx, nil); err != nil {
+		t.Fatalf("ListNamespaces: %v", err)
+	}
+
+	if got, want := aws.ToString(gotTables), "123456789012"; got != want {
+		t.Errorf("GetTables CatalogId = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(gotDatabases), "123456789012"; got != want {
+		t.Errorf("GetDatabases CatalogId = %q, want %q", got, want)
+	}
+}
+
+// TestWithCatalogID_And_WithGlueEndpoint_ConfigureOptions checks that the
+// functional options used by NewGlueCatalog set the fields they document,
+// rather than being silently ignored.
+func TestWithCatalogID_And_WithGlueEndpoint_ConfigureOptions(t *testing.T) {
+	var o glueOptions
+	for _, opt := range []GlueOption{WithCatalogID("123456789012"), WithGlueEndpoint("http://localhost:4566")} {
+		opt(&o)
+	}
+
+	if got, want := o.catalogID, "123456789012"; got != want {
+		t.Errorf("catalogID = %q, want %q", got, want)
+	}
+	if got, want := o.endpoint, "http://localhost:4566"; got != want {
+		t.Errorf("endpoint = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,121 @@
+package catalog
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/apache/iceberg-go"
+	"github.com/apache/iceberg-go/table"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+// TestGlueTypeString_MapsHiveTypes checks the Iceberg-to-Glue type string mapping
+// glueColumnsFromSchema relies on: the handful of types where Hive's name diverges
+// from Iceberg's are translated, everything else passes through unchanged.
+func TestGlueTypeString_MapsHiveTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  iceberg.Type
+		want string
+	}{
+		{"long maps to bigint", iceberg.PrimitiveTypes.Int64, "bigint"},
+		{"timestamptz maps to timestamp", iceberg.PrimitiveTypes.TimestampTz, "timestamp"},
+		{"passthrough for unmapped types", iceberg.PrimitiveTypes.String, "string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := glueTypeString(tt.typ); got != tt.want {
+				t.Errorf("glueTypeString(%v) = %q, want %q", tt.typ, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGlueColumnsFromSchema_ConvertsFields checks that each schema field becomes a
+// Glue StorageDescriptor column with its name and mapped type, in field order.
+func TestGlueColumnsFromSchema_ConvertsFields(t *testing.T) {
+	schema := iceberg.NewSchema(0,
+		iceberg.NestedField{ID: 1, Name: "id", Type: iceberg.PrimitiveTypes.Int64, Required: true},
+		iceberg.NestedField{ID: 2, Name: "event_time", Type: iceberg.PrimitiveTypes.TimestampTz, Required: false},
+	)
+
+	columns := glueColumnsFromSchema(schema)
+
+	if len(columns) != 2 {
+		t.Fatalf("got %d columns, want 2", len(columns))
+	}
+	if got, want := aws.ToString(columns[0].Name), "id"; got != want {
+		t.Errorf("columns[0].Name = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(columns[0].Type), "bigint"; got != want {
+		t.Errorf("columns[0].Type = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(columns[1].Name), "event_time"; got != want {
+		t.Errorf("columns[1].Name = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(columns[1].Type), "timestamp"; got != want {
+		t.Errorf("columns[1].Type = %q, want %q", got, want)
+	}
+}
+
+// TestGlueCatalog_CreateTable_ServerSide checks WithServerSideIcebergCreate's path:
+// the schema is sent to Glue as StorageDescriptor columns via OpenTableFormatInput,
+// and the resulting table is loaded back from the metadata_location Glue reports,
+// rather than GlueCatalog writing the metadata file itself.
+func TestGlueCatalog_CreateTable_ServerSide(t *testing.T) {
+	ctx := context.Background()
+	location := "file://" + t.TempDir()
+
+	// Seed a real metadata.json at a known location using the client-side path, so
+	// the server-side path under test has something genuine to read back.
+	seed := &fakeGlueAPI{
+		createTable: func(*glue.CreateTableInput) (*glue.CreateTableOutput, error) {
+			return &glue.CreateTableOutput{}, nil
+		},
+	}
+	seeded, err := (&GlueCatalog{glueSvc: seed}).CreateTable(ctx, table.Identifier{"db", "tbl"}, testSchema(), WithLocation(location))
+	if err != nil {
+		t.Fatalf("seed CreateTable: %v", err)
+	}
+
+	var createInput *glue.CreateTableInput
+	fake := &fakeGlueAPI{
+		createTable: func(in *glue.CreateTableInput) (*glue.CreateTableOutput, error) {
+			createInput = in
+			return &glue.CreateTableOutput{}, nil
+		},
+		getTable: func(*glue.GetTableInput) (*glue.GetTableOutput, error) {
+			return &glue.GetTableOutput{Table: &types.Table{
+				Parameters: map[string]string{"metadata_location": seeded.MetadataLocation()},
+			}}, nil
+		},
+	}
+
+	c := &GlueCatalog{glueSvc: fake, serverSideIcebergCreate: true}
+
+	tbl, err := c.CreateTable(ctx, table.Identifier{"db", "tbl"}, testSchema(), WithLocation(location), WithProperties(iceberg.Properties{"owner": "bob"}))
+	if err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+
+	if got, want := tbl.MetadataLocation(), seeded.MetadataLocation(); got != want {
+		t.Errorf("MetadataLocation = %q, want %q", got, want)
+	}
+
+	wantColumns := glueColumnsFromSchema(testSchema())
+	if !reflect.DeepEqual(createInput.TableInput.StorageDescriptor.Columns, wantColumns) {
+		t.Errorf("StorageDescriptor.Columns = %v, want %v", createInput.TableInput.StorageDescriptor.Columns, wantColumns)
+	}
+	if got, want := createInput.TableInput.Parameters["owner"], "bob"; got != want {
+		t.Errorf("Parameters[owner] = %q, want %q", got, want)
+	}
+	if createInput.OpenTableFormatInput == nil || createInput.OpenTableFormatInput.IcebergInput == nil {
+		t.Fatalf("expected OpenTableFormatInput.IcebergInput to be set for server-side create")
+	}
+	if got, want := createInput.OpenTableFormatInput.IcebergInput.MetadataOperation, types.MetadataOperationCreate; got != want {
+		t.Errorf("MetadataOperation = %v, want %v", got, want)
+	}
+}
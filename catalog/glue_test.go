@@ -0,0 +1,174 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/apache/iceberg-go"
+	"github.com/apache/iceberg-go/table"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+)
+
+// fakeGlueAPI is a GlueAPI double that forwards each call to an injectable
+// function, returning an error for any method a test didn't configure.
+type fakeGlueAPI struct {
+	getTable       func(*glue.GetTableInput) (*glue.GetTableOutput, error)
+	getTables      func(*glue.GetTablesInput) (*glue.GetTablesOutput, error)
+	createTable    func(*glue.CreateTableInput) (*glue.CreateTableOutput, error)
+	updateTable    func(*glue.UpdateTableInput) (*glue.UpdateTableOutput, error)
+	deleteTable    func(*glue.DeleteTableInput) (*glue.DeleteTableOutput, error)
+	createDatabase func(*glue.CreateDatabaseInput) (*glue.CreateDatabaseOutput, error)
+	deleteDatabase func(*glue.DeleteDatabaseInput) (*glue.DeleteDatabaseOutput, error)
+	getDatabase    func(*glue.GetDatabaseInput) (*glue.GetDatabaseOutput, error)
+	getDatabases   func(*glue.GetDatabasesInput) (*glue.GetDatabasesOutput, error)
+	updateDatabase func(*glue.UpdateDatabaseInput) (*glue.UpdateDatabaseOutput, error)
+}
+
+var _ GlueAPI = (*fakeGlueAPI)(nil)
+
+func (f *fakeGlueAPI) GetTable(_ context.Context, in *glue.GetTableInput, _ ...func(*glue.Options)) (*glue.GetTableOutput, error) {
+	if f.getTable == nil {
+		return nil, errors.New("fakeGlueAPI: GetTable not configured")
+	}
+	return f.getTable(in)
+}
+
+func (f *fakeGlueAPI) GetTables(_ context.Context, in *glue.GetTablesInput, _ ...func(*glue.Options)) (*glue.GetTablesOutput, error) {
+	if f.getTables == nil {
+		return nil, errors.New("fakeGlueAPI: GetTables not configured")
+	}
+	return f.getTables(in)
+}
+
+func (f *fakeGlueAPI) CreateTable(_ context.Context, in *glue.CreateTableInput, _ ...func(*glue.Options)) (*glue.CreateTableOutput, error) {
+	if f.createTable == nil {
+		return nil, errors.New("fakeGlueAPI: CreateTable not configured")
+	}
+	return f.createTable(in)
+}
+
+func (f *fakeGlueAPI) UpdateTable(_ context.Context, in *glue.UpdateTableInput, _ ...func(*glue.Options)) (*glue.UpdateTableOutput, error) {
+	if f.updateTable == nil {
+		return nil, errors.New("fakeGlueAPI: UpdateTable not configured")
+	}
+	return f.updateTable(in)
+}
+
+func (f *fakeGlueAPI) DeleteTable(_ context.Context, in *glue.DeleteTableInput, _ ...func(*glue.Options)) (*glue.DeleteTableOutput, error) {
+	if f.deleteTable == nil {
+		return nil, errors.New("fakeGlueAPI: DeleteTable not configured")
+	}
+	return f.deleteTable(in)
+}
+
+func (f *fakeGlueAPI) CreateDatabase(_ context.Context, in *glue.CreateDatabaseInput, _ ...func(*glue.Options)) (*glue.CreateDatabaseOutput, error) {
+	if f.createDatabase == nil {
+		return nil, errors.New("fakeGlueAPI: CreateDatabase not configured")
+	}
+	return f.createDatabase(in)
+}
+
+func (f *fakeGlueAPI) DeleteDatabase(_ context.Context, in *glue.DeleteDatabaseInput, _ ...func(*glue.Options)) (*glue.DeleteDatabaseOutput, error) {
+	if f.deleteDatabase == nil {
+		return nil, errors.New("fakeGlueAPI: DeleteDatabase not configured")
+	}
+	return f.deleteDatabase(in)
+}
+
+func (f *fakeGlueAPI) GetDatabase(_ context.Context, in *glue.GetDatabaseInput, _ ...func(*glue.Options)) (*glue.GetDatabaseOutput, error) {
+	if f.getDatabase == nil {
+		return nil, errors.New("fakeGlueAPI: GetDatabase not configured")
+	}
+	return f.getDatabase(in)
+}
+
+func (f *fakeGlueAPI) GetDatabases(_ context.Context, in *glue.GetDatabasesInput, _ ...func(*glue.Options)) (*glue.GetDatabasesOutput, error) {
+	if f.getDatabases == nil {
+		return nil, errors.New("fakeGlueAPI: GetDatabases not configured")
+	}
+	return f.getDatabases(in)
+}
+
+func (f *fakeGlueAPI) UpdateDatabase(_ context.Context, in *glue.UpdateDatabaseInput, _ ...func(*glue.Options)) (*glue.UpdateDatabaseOutput, error) {
+	if f.updateDatabase == nil {
+		return nil, errors.New("fakeGlueAPI: UpdateDatabase not configured")
+	}
+	return f.updateDatabase(in)
+}
+
+func testSchema() *iceberg.Schema {
+	return iceberg.NewSchema(0, iceberg.NestedField{ID: 1, Name: "id", Type: iceberg.PrimitiveTypes.Int64, Required: true})
+}
+
+// TestGlueCatalog_CommitTable_Succeeds exercises the happy path of the
+// optimistic-locking metadata swap: CommitTable should see its own
+// metadata_location reflected back and move the table to a new version.
+func TestGlueCatalog_CommitTable_Succeeds(t *testing.T) {
+	ctx := context.Background()
+	location := "file://" + t.TempDir()
+
+	var params map[string]string
+	fake := &fakeGlueAPI{
+		createTable: func(in *glue.CreateTableInput) (*glue.CreateTableOutput, error) {
+			params = in.TableInput.Parameters
+			return &glue.CreateTableOutput{}, nil
+		},
+		getTable: func(*glue.GetTableInput) (*glue.GetTableOutput, error) {
+			return &glue.GetTableOutput{Table: &types.Table{Parameters: params}}, nil
+		},
+		updateTable: func(in *glue.UpdateTableInput) (*glue.UpdateTableOutput, error) {
+			params = in.TableInput.Parameters
+			return &glue.UpdateTableOutput{}, nil
+		},
+	}
+
+	c := &GlueCatalog{glueSvc: fake}
+
+	tbl, err := c.CreateTable(ctx, table.Identifier{"db", "tbl"}, testSchema(), WithLocation(location))
+	if err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+
+	updated, err := c.CommitTable(ctx, tbl, nil, []TableUpdate{NewMetadataUpdate(tbl.Metadata())})
+	if err != nil {
+		t.Fatalf("CommitTable: %v", err)
+	}
+	if updated.MetadataLocation() == tbl.MetadataLocation() {
+		t.Fatalf("expected a new metadata_location after commit, got the same one: %s", updated.MetadataLocation())
+	}
+}
+
+// TestGlueCatalog_CommitTable_Conflict simulates a concurrent writer that has
+// already advanced the table: Glue always reports a metadata_location different
+// from the one CommitTable's caller loaded, so the swap should be refused with
+// ErrCommitConflict instead of silently overwriting the newer commit.
+func TestGlueCatalog_CommitTable_Conflict(t *testing.T) {
+	ctx := context.Background()
+	location := "file://" + t.TempDir()
+
+	fake := &fakeGlueAPI{
+		createTable: func(*glue.CreateTableInput) (*glue.CreateTableOutput, error) {
+			return &glue.CreateTableOutput{}, nil
+		},
+		getTable: func(*glue.GetTableInput) (*glue.GetTableOutput, error) {
+			return &glue.GetTableOutput{Table: &types.Table{Parameters: map[string]string{
+				"table_type":        "ICEBERG",
+				"metadata_location": "s3://bucket/metadata/99999-concurrent.metadata.json",
+			}}}, nil
+		},
+	}
+
+	c := &GlueCatalog{glueSvc: fake}
+
+	tbl, err := c.CreateTable(ctx, table.Identifier{"db", "tbl"}, testSchema(), WithLocation(location))
+	if err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+
+	_, err = c.CommitTable(ctx, tbl, nil, []TableUpdate{NewMetadataUpdate(tbl.Metadata())})
+	if !errors.Is(err, ErrCommitConflict) {
+		t.Fatalf("CommitTable error = %v, want ErrCommitConflict", err)
+	}
+}
@@ -0,0 +1,309 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/apache/iceberg-go"
+	"github.com/apache/iceberg-go/io"
+)
+
+// ScanOption configures a Scan built by Table.NewScan.
+type ScanOption func(*Scan)
+
+// WithRowFilter restricts the scan to rows matching filter. Manifests and data
+// files whose partition summaries or column stats cannot satisfy the filter are
+// skipped before any Parquet data is read.
+func WithRowFilter(filter iceberg.BooleanExpression) ScanOption {
+	return func(s *Scan) { s.rowFilter = filter }
+}
+
+// WithProjectedSchema restricts the columns read from each data file to schema.
+// When unset, the scan reads the table's current schema in full.
+func WithProjectedSchema(schema *iceberg.Schema) ScanOption {
+	return func(s *Scan) { s.projectedSchema = schema }
+}
+
+// WithSnapshotID scans the table as of a specific snapshot rather than its current
+// snapshot.
+func WithSnapshotID(id int64) ScanOption {
+	return func(s *Scan) { s.snapshotID = &id }
+}
+
+// WithBranch scans the table as of the tip of the named branch or tag.
+func WithBranch(branch string) ScanOption {
+	return func(s *Scan) { s.branch = branch }
+}
+
+// Scan plans and reads a table's data files for a single snapshot, producing an
+// Arrow record stream. It is modeled after Arrow ADBC's Statement.ExecuteQuery:
+// callers get back a RecordReader and pull batches rather than materializing the
+// whole result set, so downstream engines (DuckDB, ADBC drivers) can consume
+// Iceberg tables directly with predicate pushdown.
+type Scan struct {
+	tbl Table
+
+	snapshotID      *int64
+	branch          string
+	rowFilter       iceberg.BooleanExpression
+	projectedSchema *iceberg.Schema
+}
+
+// NewScan builds a Scan over t's current snapshot. Use WithSnapshotID or
+// WithBranch to scan a different point in the table's history, WithRowFilter to
+// push predicates into file planning, and WithProjectedSchema to read a subset of
+// columns.
+func (t Table) NewScan(opts ...ScanOption) *Scan {
+	s := &Scan{tbl: t, rowFilter: iceberg.AlwaysTrue{}}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// snapshot resolves which of the table's snapshots this scan reads.
+func (s *Scan) snapshot() (*Snapshot, error) {
+	switch {
+	case s.snapshotID != nil:
+		snap := s.tbl.SnapshotByID(*s.snapshotID)
+		if snap == nil {
+			return nil, fmt.Errorf("no snapshot with id %d", *s.snapshotID)
+		}
+		return snap, nil
+	case s.branch != "":
+		snap := s.tbl.SnapshotByName(s.branch)
+		if snap == nil {
+			return nil, fmt.Errorf("no snapshot for branch %q", s.branch)
+		}
+		return snap, nil
+	default:
+		snap := s.tbl.CurrentSnapshot()
+		if snap == nil {
+			return nil, fmt.Errorf("table %v has no snapshots to scan", s.tbl.Identifier())
+		}
+		return snap, nil
+	}
+}
+
+// projection returns the schema rows are read and emitted with.
+func (s *Scan) projection() *iceberg.Schema {
+	if s.projectedSchema != nil {
+		return s.projectedSchema
+	}
+	return s.tbl.Schema()
+}
+
+// FileScanTask describes a single data file a Scan must read.
+type FileScanTask struct {
+	DataFile iceberg.DataFile
+}
+
+// planFiles walks the snapshot's manifest list, skipping manifests and data files
+// whose partition summaries or column stats cannot satisfy s.rowFilter, and
+// returns the surviving data files as scan tasks.
+func (s *Scan) planFiles(snap *Snapshot) ([]FileScanTask, error) {
+	manifests, err := snap.Manifests(s.tbl.FS())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest list: %w", err)
+	}
+
+	spec := s.tbl.Spec()
+
+	var tasks []FileScanTask
+	for _, manifest := range manifests {
+		if !manifestMayMatch(manifest, spec, s.rowFilter) {
+			continue
+		}
+
+		entries, err := manifest.FetchEntries(s.tbl.FS(), false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest %s: %w", manifest.FilePath(), err)
+		}
+
+		for _, entry := range entries {
+			if entry.Status() == iceberg.EntryStatusDeleted {
+				continue
+			}
+			if !dataFileMayMatch(entry.DataFile(), spec, s.rowFilter) {
+				continue
+			}
+
+			tasks = append(tasks, FileScanTask{DataFile: entry.DataFile()})
+		}
+	}
+
+	return tasks, nil
+}
+
+// manifestMayMatch reports whether a manifest may hold rows matching filter; it
+// returns false only when the manifest's partition field summaries rule out every
+// such row. It is a coarse, cheap pre-filter: a true result means "maybe", not
+// "definitely".
+func manifestMayMatch(manifest iceberg.ManifestFile, spec iceberg.PartitionSpec, filter iceberg.BooleanExpression) bool {
+	if _, ok := filter.(iceberg.AlwaysTrue); ok {
+		return true
+	}
+
+	summaries := manifest.Partitions()
+	if len(summaries) == 0 {
+		return true
+	}
+
+	return iceberg.ExpressionMatchesPartitionSummary(filter, spec, summaries)
+}
+
+// dataFileMayMatch reports whether a data file may hold rows matching filter; it
+// returns false only when the file's per-column stats rule out every such row.
+func dataFileMayMatch(dataFile iceberg.DataFile, spec iceberg.PartitionSpec, filter iceberg.BooleanExpression) bool {
+	if _, ok := filter.(iceberg.AlwaysTrue); ok {
+		return true
+	}
+
+	return iceberg.ExpressionMatchesDataFileStats(filter, spec, dataFile)
+}
+
+// ToArrow plans this scan's data files and returns a RecordReader that streams
+// their rows as Arrow record batches. Files are read one at a time via the
+// table's io.IO, so at most one file's row groups are buffered in memory.
+func (s *Scan) ToArrow(ctx context.Context) (arrow.RecordReader, error) {
+	snap, err := s.snapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.planFiles(snap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan files for table %v: %w", s.tbl.Identifier(), err)
+	}
+
+	schema, err := iceberg.SchemaToArrowSchema(s.projection(), nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive arrow schema: %w", err)
+	}
+
+	return &fileTaskRecordReader{ctx: ctx, fs: s.tbl.FS(), schema: schema, tasks: tasks, refs: 1}, nil
+}
+
+// fileTaskRecordReader implements arrow.RecordReader over a sequence of
+// FileScanTasks, opening and streaming one data file's Parquet content at a time.
+type fileTaskRecordReader struct {
+	ctx    context.Context
+	fs     io.IO
+	schema *arrow.Schema
+	tasks  []FileScanTask
+
+	refs int64
+	cur  arrow.RecordReader
+	err  error
+}
+
+func (r *fileTaskRecordReader) Retain() { atomic.AddInt64(&r.refs, 1) }
+
+func (r *fileTaskRecordReader) Release() {
+	if atomic.AddInt64(&r.refs, -1) == 0 {
+		r.closeCurrent()
+	}
+}
+
+func (r *fileTaskRecordReader) Schema() *arrow.Schema { return r.schema }
+
+func (r *fileTaskRecordReader) Next() bool {
+	for {
+		if r.cur != nil {
+			if r.cur.Next() {
+				return true
+			}
+			if err := r.cur.Err(); err != nil {
+				r.err = err
+				r.closeCurrent()
+				return false
+			}
+			r.closeCurrent()
+		}
+
+		if len(r.tasks) == 0 {
+			return false
+		}
+
+		task := r.tasks[0]
+		r.tasks = r.tasks[1:]
+
+		reader, err := r.openTask(task)
+		if err != nil {
+			r.err = err
+			return false
+		}
+		r.cur = reader
+	}
+}
+
+func (r *fileTaskRecordReader) Record() arrow.Record {
+	if r.cur == nil {
+		return nil
+	}
+	return r.cur.Record()
+}
+
+// Err returns the first error that stopped the scan early, from either opening a
+// data file or reading its row groups. A nil Err after Next returns false means the
+// scan reached the end of its planned files cleanly.
+func (r *fileTaskRecordReader) Err() error { return r.err }
+
+func (r *fileTaskRecordReader) closeCurrent() {
+	if r.cur != nil {
+		r.cur.Release()
+		r.cur = nil
+	}
+}
+
+// openTask opens task's data file via r.fs and returns an arrow.RecordReader over
+// its row groups, projected to r.schema: GetRecordReader is given the physical
+// column indices for r.schema's fields rather than nil (every column), so a
+// narrower WithProjectedSchema is actually reflected in what's read off disk.
+func (r *fileTaskRecordReader) openTask(task FileScanTask) (arrow.RecordReader, error) {
+	f, err := r.fs.Open(task.DataFile.FilePath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open data file %s: %w", task.DataFile.FilePath(), err)
+	}
+
+	pf, err := file.NewParquetReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open parquet file %s: %w", task.DataFile.FilePath(), err)
+	}
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create arrow reader for %s: %w", task.DataFile.FilePath(), err)
+	}
+
+	fileSchema, err := fr.Schema()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read arrow schema for %s: %w", task.DataFile.FilePath(), err)
+	}
+
+	columnIndices := make([]int, 0, len(r.schema.Fields()))
+	var addLeaves func(sf pqarrow.SchemaField)
+	addLeaves = func(sf pqarrow.SchemaField) {
+		if sf.ColIndex >= 0 {
+			columnIndices = append(columnIndices, sf.ColIndex)
+			return
+		}
+		for _, child := range sf.Children {
+			addLeaves(child)
+		}
+	}
+	for _, field := range r.schema.Fields() {
+		idx := fileSchema.FieldIndices(field.Name)
+		if len(idx) == 0 {
+			return nil, fmt.Errorf("data file %s has no column %q required by the scan's projection", task.DataFile.FilePath(), field.Name)
+		}
+		addLeaves(fr.Manifest.Fields[idx[0]])
+	}
+
+	return fr.GetRecordReader(r.ctx, columnIndices, nil)
+}
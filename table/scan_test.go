@@ -0,0 +1,115 @@
+package table
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/iceberg-go"
+)
+
+// TestManifestMayMatch_AlwaysTrueShortCircuits checks that an AlwaysTrue filter
+// returns true without ever looking at the manifest's partition summaries, so a
+// scan with no row filter can't be tripped up by a nil/zero-value manifest.
+func TestManifestMayMatch_AlwaysTrueShortCircuits(t *testing.T) {
+	if !manifestMayMatch(nil, iceberg.PartitionSpec{}, iceberg.AlwaysTrue{}) {
+		t.Fatal("manifestMayMatch with AlwaysTrue filter = false, want true")
+	}
+}
+
+// TestDataFileMayMatch_AlwaysTrueShortCircuits is manifestMayMatch's short-circuit
+// test, for dataFileMayMatch instead.
+func TestDataFileMayMatch_AlwaysTrueShortCircuits(t *testing.T) {
+	if !dataFileMayMatch(nil, iceberg.PartitionSpec{}, iceberg.AlwaysTrue{}) {
+		t.Fatal("dataFileMayMatch with AlwaysTrue filter = false, want true")
+	}
+}
+
+// fakeArrowRecordReader is an arrow.RecordReader double whose Next results and
+// terminal error are scripted up front, so fileTaskRecordReader's state machine
+// can be exercised without a real Parquet file.
+type fakeArrowRecordReader struct {
+	nextResults []bool
+	err         error
+	released    bool
+}
+
+func (f *fakeArrowRecordReader) Retain() {}
+
+func (f *fakeArrowRecordReader) Release() { f.released = true }
+
+func (f *fakeArrowRecordReader) Schema() *arrow.Schema { return nil }
+
+func (f *fakeArrowRecordReader) Next() bool {
+	if len(f.nextResults) == 0 {
+		return false
+	}
+	next := f.nextResults[0]
+	f.nextResults = f.nextResults[1:]
+	return next
+}
+
+func (f *fakeArrowRecordReader) Record() arrow.Record { return nil }
+
+func (f *fakeArrowRecordReader) Err() error { return f.err }
+
+var _ arrow.RecordReader = (*fakeArrowRecordReader)(nil)
+
+// TestFileTaskRecordReader_PropagatesCurrentReaderError checks that an error from
+// the current file's reader surfaces via Err() and releases that reader, rather
+// than being swallowed or left for the next Next() call to trip over.
+func TestFileTaskRecordReader_PropagatesCurrentReaderError(t *testing.T) {
+	wantErr := errors.New("row group decode failed")
+	cur := &fakeArrowRecordReader{nextResults: []bool{false}, err: wantErr}
+	r := &fileTaskRecordReader{refs: 1, cur: cur}
+
+	if r.Next() {
+		t.Fatal("Next() = true, want false on reader error")
+	}
+	if !errors.Is(r.Err(), wantErr) {
+		t.Fatalf("Err() = %v, want %v", r.Err(), wantErr)
+	}
+	if !cur.released {
+		t.Error("expected the failed reader to be released")
+	}
+	if r.cur != nil {
+		t.Error("expected r.cur to be cleared after the reader failed")
+	}
+}
+
+// TestFileTaskRecordReader_CleanEOFWithNoMoreTasksStopsWithoutError checks that
+// reaching the end of the current file cleanly (Err() == nil) with no further
+// planned tasks stops the scan without reporting an error.
+func TestFileTaskRecordReader_CleanEOFWithNoMoreTasksStopsWithoutError(t *testing.T) {
+	cur := &fakeArrowRecordReader{nextResults: []bool{false}}
+	r := &fileTaskRecordReader{refs: 1, cur: cur, tasks: nil}
+
+	if r.Next() {
+		t.Fatal("Next() = true, want false at clean EOF with no more tasks")
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil", err)
+	}
+	if !cur.released {
+		t.Error("expected the exhausted reader to be released")
+	}
+}
+
+// TestFileTaskRecordReader_RefCounting checks that Release only tears down the
+// current reader once the ref count actually reaches zero, so a Retain'd reader
+// held by multiple owners survives a single Release call.
+func TestFileTaskRecordReader_RefCounting(t *testing.T) {
+	cur := &fakeArrowRecordReader{}
+	r := &fileTaskRecordReader{refs: 1, cur: cur}
+
+	r.Retain()
+	r.Release()
+	if cur.released {
+		t.Fatal("reader released too early: refs should still be 1")
+	}
+
+	r.Release()
+	if !cur.released {
+		t.Fatal("expected reader to be released once refs reached 0")
+	}
+}